@@ -47,6 +47,7 @@ import (
 	"github.com/c2FmZQ/sshterm/internal/indexeddb"
 	"github.com/c2FmZQ/sshterm/internal/jsutil"
 	"github.com/c2FmZQ/sshterm/internal/shellwords"
+	"github.com/c2FmZQ/sshterm/internal/storage"
 	"github.com/c2FmZQ/sshterm/internal/terminal"
 )
 
@@ -78,7 +79,11 @@ func New(cfg *Config) (*App, error) {
 			Keys:        make(map[string]*key),
 			Params:      make(map[string]any),
 		},
-		inShell: new(atomic.Bool),
+		openStore: func(name string) (storage.Store, error) {
+			return indexeddb.New(name)
+		},
+		deleteStore: indexeddb.Delete,
+		inShell:     new(atomic.Bool),
 	}
 	app.commands = []*cli.App{
 		{
@@ -127,7 +132,9 @@ type App struct {
 	cancel        context.CancelFunc
 	term          *terminal.Terminal
 	autoCompleter *autoCompleter
-	db            *indexeddb.DB
+	db            storage.Store
+	openStore     func(name string) (storage.Store, error)
+	deleteStore   func(name string) error
 	data          appData
 	lastDBRefresh time.Time
 	bc            js.Value
@@ -239,11 +246,11 @@ func (a *App) initDB() error {
 		a.db = nil
 	}
 	if !a.data.Persist {
-		return indexeddb.Delete(a.cfg.DBName)
+		return a.deleteStore(a.cfg.DBName)
 	}
-	db, err := indexeddb.New(a.cfg.DBName)
+	db, err := a.openStore(a.cfg.DBName)
 	if err != nil {
-		return fmt.Errorf("indexeddb.New: %w", err)
+		return fmt.Errorf("openStore: %w", err)
 	}
 	a.db = db
 	return a.refreshDB()
@@ -263,19 +270,19 @@ func (a *App) refreshDB() error {
 			k.errorf = a.term.Errorf
 		}
 	}()
-	if err := a.db.Get("authorities", &a.data.Authorities); err != nil && err != indexeddb.ErrNotFound {
+	if err := a.db.Get("authorities", &a.data.Authorities); err != nil && !errors.Is(err, storage.ErrNotFound) {
 		return fmt.Errorf("authorities load: %w", err)
 	}
-	if err := a.db.Get("endpoints", &a.data.Endpoints); err != nil && err != indexeddb.ErrNotFound {
+	if err := a.db.Get("endpoints", &a.data.Endpoints); err != nil && !errors.Is(err, storage.ErrNotFound) {
 		return fmt.Errorf("endpoints load: %w", err)
 	}
-	if err := a.db.Get("hosts", &a.data.Hosts); err != nil && err != indexeddb.ErrNotFound {
+	if err := a.db.Get("hosts", &a.data.Hosts); err != nil && !errors.Is(err, storage.ErrNotFound) {
 		return fmt.Errorf("hosts load: %w", err)
 	}
-	if err := a.db.Get("keys", &a.data.Keys); err != nil && err != indexeddb.ErrNotFound {
+	if err := a.db.Get("keys", &a.data.Keys); err != nil && !errors.Is(err, storage.ErrNotFound) {
 		return fmt.Errorf("keys load: %w", err)
 	}
-	if err := a.db.Get("params", &a.data.Params); err != nil && err != indexeddb.ErrNotFound {
+	if err := a.db.Get("params", &a.data.Params); err != nil && !errors.Is(err, storage.ErrNotFound) {
 		return fmt.Errorf("params load: %w", err)
 	}
 	for k, v := range a.data.Endpoints {
@@ -30,6 +30,8 @@ import (
 	"errors"
 	"fmt"
 	"syscall/js"
+
+	"github.com/c2FmZQ/sshterm/internal/storage"
 )
 
 const (
@@ -37,7 +39,9 @@ const (
 	dbVersion = 2
 )
 
-var ErrNotFound = errors.New("not found")
+var ErrNotFound = storage.ErrNotFound
+
+var _ storage.Store = (*DB)(nil)
 
 func Delete(name string) error {
 	req := js.Global().Get("indexedDB").Call("deleteDatabase", js.ValueOf(name))
@@ -0,0 +1,98 @@
+// MIT License
+//
+// Copyright (c) 2024 TTBT Enterprises LLC
+// Copyright (c) 2024 Robin Thellend <rthellend@rthellend.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build wasm
+
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"syscall/js"
+)
+
+var _ Store = (*LocalStorage)(nil)
+
+// LocalStorage is a Store backed by the browser's localStorage. All the keys
+// are prefixed with the store's name.
+type LocalStorage struct {
+	prefix string
+	ls     js.Value
+}
+
+func NewLocalStorage(name string) (*LocalStorage, error) {
+	ls := js.Global().Get("localStorage")
+	if ls.IsUndefined() || ls.IsNull() {
+		return nil, fmt.Errorf("localStorage is not available")
+	}
+	return &LocalStorage{
+		prefix: name + "/",
+		ls:     ls,
+	}, nil
+}
+
+func DeleteLocalStorage(name string) error {
+	ls := js.Global().Get("localStorage")
+	if ls.IsUndefined() || ls.IsNull() {
+		return nil
+	}
+	prefix := name + "/"
+	var keys []string
+	for i := 0; i < ls.Get("length").Int(); i++ {
+		if k := ls.Call("key", i).String(); strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	for _, k := range keys {
+		ls.Call("removeItem", k)
+	}
+	return nil
+}
+
+func (s *LocalStorage) Close() {}
+
+func (s *LocalStorage) Get(key string, value any) error {
+	v := s.ls.Call("getItem", s.prefix+key)
+	if v.IsNull() {
+		return ErrNotFound
+	}
+	if err := json.Unmarshal([]byte(v.String()), value); err != nil {
+		return fmt.Errorf("json.Unmarshal: %w", err)
+	}
+	return nil
+}
+
+func (s *LocalStorage) Set(key string, value any) (err error) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("localStorage.setItem: %v", r)
+		}
+	}()
+	s.ls.Call("setItem", s.prefix+key, string(b))
+	return nil
+}
@@ -0,0 +1,121 @@
+// MIT License
+//
+// Copyright (c) 2024 TTBT Enterprises LLC
+// Copyright (c) 2024 Robin Thellend <rthellend@rthellend.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package storage
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+type testValue struct {
+	Name  string            `json:"name"`
+	Key   []byte            `json:"key"`
+	Hosts map[string]string `json:"hosts"`
+}
+
+func TestStores(t *testing.T) {
+	dir, err := NewDir(filepath.Join(t.TempDir(), "db"))
+	if err != nil {
+		t.Fatalf("NewDir: %v", err)
+	}
+	for _, tc := range []struct {
+		name string
+		s    Store
+	}{
+		{"Memory", NewMemory()},
+		{"Dir", dir},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			defer tc.s.Close()
+			var got testValue
+			if err := tc.s.Get("foo", &got); !errors.Is(err, ErrNotFound) {
+				t.Fatalf("Get(foo) = %v, want ErrNotFound", err)
+			}
+
+			want := testValue{Name: "foo", Key: []byte{1, 2, 3}, Hosts: map[string]string{"a": "b"}}
+			if err := tc.s.Set("foo", want); err != nil {
+				t.Fatalf("Set(foo): %v", err)
+			}
+			if err := tc.s.Get("foo", &got); err != nil {
+				t.Fatalf("Get(foo): %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Get(foo) = %#v, want %#v", got, want)
+			}
+
+			if err := tc.s.Set("foo", "bar"); err != nil {
+				t.Fatalf("Set(foo): %v", err)
+			}
+			var s string
+			if err := tc.s.Get("foo", &s); err != nil || s != "bar" {
+				t.Errorf("Get(foo) = %q, %v, want bar", s, err)
+			}
+			if err := tc.s.Get("foo", &got); err == nil {
+				t.Error("Get(foo) into struct succeeded, want error")
+			}
+		})
+	}
+}
+
+func TestDir(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "db")
+	d, err := NewDir(path)
+	if err != nil {
+		t.Fatalf("NewDir: %v", err)
+	}
+	for _, key := range []string{"", ".", "..", ".hidden", "a/b", "../x", `a\b`} {
+		if err := d.Set(key, 1); err == nil {
+			t.Errorf("Set(%q) succeeded, want error", key)
+		}
+	}
+	if err := d.Set("hosts", []string{"a", "b"}); err != nil {
+		t.Fatalf("Set(hosts): %v", err)
+	}
+
+	// A new Dir in the same directory sees the same data.
+	d2, err := NewDir(path)
+	if err != nil {
+		t.Fatalf("NewDir: %v", err)
+	}
+	var got []string
+	if err := d2.Get("hosts", &got); err != nil {
+		t.Fatalf("Get(hosts): %v", err)
+	}
+	if want := []string{"a", "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Get(hosts) = %v, want %v", got, want)
+	}
+
+	if err := DeleteDir(path); err != nil {
+		t.Fatalf("DeleteDir: %v", err)
+	}
+	d3, err := NewDir(path)
+	if err != nil {
+		t.Fatalf("NewDir: %v", err)
+	}
+	if err := d3.Get("hosts", &got); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get(hosts) after DeleteDir = %v, want ErrNotFound", err)
+	}
+}